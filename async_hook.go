@@ -0,0 +1,110 @@
+package goklogger
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// AsyncHook wraps another logrus.Hook so that Fire returns immediately,
+// buffering entries on a channel and draining them on a background
+// goroutine. This keeps slow sinks (HTTP collectors, syslog over the
+// network, etc.) from blocking request paths.
+type AsyncHook struct {
+	inner logrus.Hook
+	queue chan *logrus.Entry
+	done  chan struct{}
+	wg    sync.WaitGroup
+}
+
+// NewAsyncHook starts a background goroutine that drains entries into inner
+// and returns the wrapping hook. bufSize sets the capacity of the internal
+// channel; once full, Fire blocks the caller until space frees up.
+func NewAsyncHook(inner logrus.Hook, bufSize int) *AsyncHook {
+	h := &AsyncHook{
+		inner: inner,
+		queue: make(chan *logrus.Entry, bufSize),
+		done:  make(chan struct{}),
+	}
+
+	h.wg.Add(1)
+	go h.run()
+
+	return h
+}
+
+func (h *AsyncHook) run() {
+	defer h.wg.Done()
+
+	for {
+		select {
+		case entry := <-h.queue:
+			_ = h.inner.Fire(entry)
+
+		case <-h.done:
+			h.drain()
+
+			return
+		}
+	}
+}
+
+// drain flushes any entries still buffered in queue before returning.
+func (h *AsyncHook) drain() {
+	for {
+		select {
+		case entry := <-h.queue:
+			_ = h.inner.Fire(entry)
+		default:
+			return
+		}
+	}
+}
+
+// Levels delegates to the wrapped hook.
+func (h *AsyncHook) Levels() []logrus.Level {
+	return h.inner.Levels()
+}
+
+// Fire enqueues entry for asynchronous delivery to the wrapped hook. The
+// entry is copied first since logrus may reuse or mutate the original after
+// Fire returns; logrus's own Entry.Dup() doesn't preserve Message or Level,
+// so the copy is built by hand.
+func (h *AsyncHook) Fire(entry *logrus.Entry) error {
+	data := make(logrus.Fields, len(entry.Data))
+	for k, v := range entry.Data {
+		data[k] = v
+	}
+
+	h.queue <- &logrus.Entry{
+		Logger:  entry.Logger,
+		Data:    data,
+		Time:    entry.Time,
+		Level:   entry.Level,
+		Message: entry.Message,
+	}
+
+	return nil
+}
+
+// Close signals the background goroutine to flush any buffered entries and
+// stop, waiting for it to finish or for ctx to be canceled, whichever comes
+// first.
+func (h *AsyncHook) Close(ctx context.Context) error {
+	close(h.done)
+
+	finished := make(chan struct{})
+
+	go func() {
+		h.wg.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
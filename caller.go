@@ -0,0 +1,156 @@
+package goklogger
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// internalFrameSuffixes names goklogger's own entry points and helpers that
+// findCaller walks past to reach the real call site. A frame is considered
+// "internal" only if its function name ends in one of these, rather than
+// merely sharing this package's import path — otherwise code that happens
+// to live in this package (including this file's own tests) would be
+// mistaken for part of the logging plumbing.
+var internalFrameSuffixes = []string{
+	".findCaller",
+	".(*KLogger).injectCaller",
+	".Logf",
+	".Logfc",
+	".(*KLogger).Info",
+	".(*KLogger).Warn",
+	".(*KLogger).Debug",
+	".(*KLogger).Error",
+	".(*KLogger).Fatal",
+	".(*KLogger).Panic",
+}
+
+// isInternalFrame reports whether funcName belongs to goklogger's own
+// logging plumbing rather than to a caller of it.
+func isInternalFrame(funcName string) bool {
+	for _, suffix := range internalFrameSuffixes {
+		if strings.HasSuffix(funcName, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reportCaller controls whether log entries get a "caller" field describing
+// the file, function, and line that produced them. It's guarded by an
+// atomic so it can be toggled at runtime (e.g. from a SIGHUP handler or an
+// admin endpoint) while other goroutines are concurrently logging.
+var reportCaller atomic.Bool
+
+// SetReportCaller enables or disables automatic caller enrichment. When
+// enabled, every log entry gets a "caller" field in the form
+// "filename.functionname:linenumber" identifying the first stack frame
+// outside goklogger itself.
+func SetReportCaller(enabled bool) {
+	reportCaller.Store(enabled)
+}
+
+// skipCache remembers, for a single entry-point function (e.g. "Info"), the
+// index into the call stack of the first frame outside goklogger. The
+// index is the same on every call from a given entry point, so it only
+// needs to be discovered once.
+type skipCache struct {
+	once sync.Once
+	idx  int
+}
+
+// callerSkipDepth maps an entry-point name to its cached skipCache.
+var callerSkipDepth sync.Map // map[string]*skipCache
+
+// injectCaller adds a "caller" field to l.Data describing the first stack
+// frame outside goklogger, if caller reporting is enabled. entryPoint names
+// the exported method calling this (e.g. "Info") and is used to cache the
+// frame skip depth so repeated calls from the same entry point don't have
+// to re-walk the stack comparing frame names.
+func (l *KLogger) injectCaller(entryPoint string) {
+	if !reportCaller.Load() {
+		return
+	}
+
+	if caller := findCaller(entryPoint); caller != "" {
+		l.Data["caller"] = caller
+	}
+}
+
+// findCaller returns "filename.functionname:linenumber" for the first stack
+// frame outside goklogger, as seen from this call.
+func findCaller(entryPoint string) string {
+	const maxDepth = 32
+
+	pcs := make([]uintptr, maxDepth)
+	n := runtime.Callers(1, pcs) // 1 = findCaller's own frame (0 is runtime.Callers itself)
+
+	if n == 0 {
+		return ""
+	}
+
+	idx := cachedExternalFrameIndex(entryPoint, pcs[:n])
+	if idx >= n {
+		return ""
+	}
+
+	frame, _ := runtime.CallersFrames(pcs[idx : idx+1]).Next()
+	if frame.PC == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s.%s:%d", filepath.Base(frame.File), shortFuncName(frame.Function), frame.Line)
+}
+
+// shortFuncName trims a fully qualified function name as reported by
+// runtime.Frame (e.g. "github.com/kmesiab/go-klogger.(*KLogger).Info") down
+// to just the function/method part (e.g. "(*KLogger).Info"), so the
+// "caller" field reads as "filename.functionname:linenumber" rather than
+// embedding the whole import path.
+func shortFuncName(funcName string) string {
+	if idx := strings.LastIndex(funcName, "/"); idx >= 0 {
+		funcName = funcName[idx+1:]
+	}
+
+	if idx := strings.Index(funcName, "."); idx >= 0 {
+		funcName = funcName[idx+1:]
+	}
+
+	return funcName
+}
+
+// cachedExternalFrameIndex returns the index within pcs of the first frame
+// outside goklogger, computing and caching it the first time it's asked for
+// a given entryPoint.
+func cachedExternalFrameIndex(entryPoint string, pcs []uintptr) int {
+	cacheIface, _ := callerSkipDepth.LoadOrStore(entryPoint, &skipCache{})
+	cache := cacheIface.(*skipCache)
+
+	cache.once.Do(func() {
+		cache.idx = externalFrameIndex(pcs)
+	})
+
+	return cache.idx
+}
+
+// externalFrameIndex walks pcs looking for the first frame that isn't part
+// of goklogger's own logging plumbing, returning its index.
+func externalFrameIndex(pcs []uintptr) int {
+	frames := runtime.CallersFrames(pcs)
+
+	for i := 0; ; i++ {
+		frame, more := frames.Next()
+
+		if !isInternalFrame(frame.Function) {
+			return i
+		}
+
+		if !more {
+			return i
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package goklogger
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func newDiscardingLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	return logger
+}
+
+func TestInfoAddsCallerField(t *testing.T) {
+	SetReportCaller(true)
+	defer SetReportCaller(false)
+
+	l := &KLogger{
+		Logger:   newDiscardingLogger(),
+		LogLevel: logrus.InfoLevel,
+		Data:     make(map[string]interface{}),
+	}
+	l.Info()
+
+	caller, ok := l.Data["caller"].(string)
+	if !ok || caller == "" {
+		t.Fatalf("expected a non-empty caller field, got %v", l.Data["caller"])
+	}
+
+	if !strings.Contains(caller, "caller_test.go") {
+		t.Errorf("expected caller to reference this test file, got %q", caller)
+	}
+
+	if strings.ContainsAny(caller, "/\\") {
+		t.Errorf("expected caller to use a bare filename, not a path, got %q", caller)
+	}
+}
+
+func TestInjectCallerNoopWhenDisabled(t *testing.T) {
+	l := &KLogger{
+		Logger:   newDiscardingLogger(),
+		LogLevel: logrus.InfoLevel,
+		Data:     make(map[string]interface{}),
+	}
+	l.Info()
+
+	if _, ok := l.Data["caller"]; ok {
+		t.Error("expected no caller field when report caller is disabled")
+	}
+}
@@ -0,0 +1,65 @@
+package goklogger
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// Guards contextFields against concurrent reads and writes.
+	contextFieldsMu sync.RWMutex
+
+	// contextFields maps a context key to the name of the l.Data field its
+	// value should be copied into when a KLogger is built from a context.
+	contextFields = make(map[interface{}]string)
+)
+
+// RegisterContextField registers a context key whose value should be
+// automatically copied into l.Data under logField whenever a KLogger picks
+// up a context via Logfc or WithContext. This gives drop-in integration
+// with OpenTelemetry/W3C trace-context middleware, where values like
+// traceparent, trace_id, and span_id live on the request's context.Context.
+func RegisterContextField(ctxKey interface{}, logField string) {
+	contextFieldsMu.Lock()
+	defer contextFieldsMu.Unlock()
+
+	contextFields[ctxKey] = logField
+}
+
+// Logfc behaves like Logf but also attaches ctx to the returned KLogger via
+// WithContext.
+func Logfc(ctx context.Context, format string, vars ...interface{}) *KLogger {
+	return Logf(format, vars...).WithContext(ctx)
+}
+
+// WithContext attaches ctx to l, copying in any fields registered via
+// RegisterContextField and making Info/Warn/Debug emission respect ctx's
+// cancellation.
+func (l *KLogger) WithContext(ctx context.Context) *KLogger {
+	l.Ctx = ctx
+
+	contextFieldsMu.RLock()
+	defer contextFieldsMu.RUnlock()
+
+	for ctxKey, logField := range contextFields {
+		if value := ctx.Value(ctxKey); value != nil {
+			l.Data[logField] = value
+		}
+	}
+
+	return l
+}
+
+// shouldEmit reports whether an entry at level should be emitted given l's
+// context. Entries are always emitted when l has no attached context, when
+// that context hasn't been canceled, or when the level is severe enough
+// (Error, Fatal, Panic) that cancellation shouldn't silence it.
+func (l *KLogger) shouldEmit(level logrus.Level) bool {
+	if l.Ctx == nil || level <= logrus.ErrorLevel {
+		return true
+	}
+
+	return l.Ctx.Err() == nil
+}
@@ -0,0 +1,46 @@
+package goklogger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+type traceIDKey struct{}
+
+func TestWithContextCopiesRegisteredFields(t *testing.T) {
+	RegisterContextField(traceIDKey{}, "trace_id")
+
+	ctx := context.WithValue(context.Background(), traceIDKey{}, "abc-123")
+
+	l := &KLogger{Data: make(map[string]interface{})}
+	l.WithContext(ctx)
+
+	if l.Data["trace_id"] != "abc-123" {
+		t.Errorf("expected trace_id to be copied from context, got %v", l.Data["trace_id"])
+	}
+}
+
+func TestShouldEmitRespectsCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	l := &KLogger{Data: make(map[string]interface{}), Ctx: ctx}
+
+	if l.shouldEmit(logrus.InfoLevel) {
+		t.Error("expected Info-level emission to be skipped on a canceled context")
+	}
+
+	if !l.shouldEmit(logrus.ErrorLevel) {
+		t.Error("expected Error-level emission to proceed even on a canceled context")
+	}
+}
+
+func TestShouldEmitWithoutContext(t *testing.T) {
+	l := &KLogger{Data: make(map[string]interface{})}
+
+	if !l.shouldEmit(logrus.InfoLevel) {
+		t.Error("expected emission to proceed when no context is attached")
+	}
+}
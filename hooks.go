@@ -0,0 +1,43 @@
+package goklogger
+
+import "github.com/sirupsen/logrus"
+
+// AddHook registers hook with the global logger so that it receives every
+// entry the global logger emits at one of the levels hook.Levels() reports.
+func AddHook(hook logrus.Hook) {
+	InitializeGlobalLogger(DefaultLogLevel, &logrus.JSONFormatter{})
+
+	globalLogger.AddHook(hook)
+}
+
+// AddHookForLevels registers hook with the global logger, restricted to fire
+// only for the given levels. This overrides whatever Levels() hook itself
+// reports, so the same hook can be reused at different verbosities in
+// different places.
+func AddHookForLevels(hook logrus.Hook, levels ...LogLevel) {
+	InitializeGlobalLogger(DefaultLogLevel, &logrus.JSONFormatter{})
+
+	logrusLevels := make([]logrus.Level, len(levels))
+	for i, level := range levels {
+		logrusLevels[i] = level.toLogrusLevel()
+	}
+
+	globalLogger.AddHook(&levelHook{hook: hook, levels: logrusLevels})
+}
+
+// levelHook wraps a logrus.Hook so that it only fires for a fixed subset of
+// levels, regardless of what Levels() the wrapped hook itself reports.
+type levelHook struct {
+	hook   logrus.Hook
+	levels []logrus.Level
+}
+
+// Levels returns the fixed subset of levels this wrapper was created with.
+func (h *levelHook) Levels() []logrus.Level {
+	return h.levels
+}
+
+// Fire delegates to the wrapped hook.
+func (h *levelHook) Fire(entry *logrus.Entry) error {
+	return h.hook.Fire(entry)
+}
@@ -0,0 +1,75 @@
+package goklogger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// recordingHook captures every entry fired to it.
+type recordingHook struct {
+	fired chan *logrus.Entry
+}
+
+func newRecordingHook() *recordingHook {
+	return &recordingHook{fired: make(chan *logrus.Entry, 10)}
+}
+
+func (h *recordingHook) Levels() []logrus.Level { return logrus.AllLevels }
+
+func (h *recordingHook) Fire(entry *logrus.Entry) error {
+	h.fired <- entry
+	return nil
+}
+
+func TestLevelHookRestrictsLevels(t *testing.T) {
+	inner := newRecordingHook()
+	wrapped := &levelHook{hook: inner, levels: []logrus.Level{logrus.ErrorLevel}}
+
+	levels := wrapped.Levels()
+	if len(levels) != 1 || levels[0] != logrus.ErrorLevel {
+		t.Fatalf("expected Levels() to be [Error], got %v", levels)
+	}
+
+	entry := &logrus.Entry{Message: "boom"}
+	if err := wrapped.Fire(entry); err != nil {
+		t.Fatalf("Fire returned an unexpected error: %v", err)
+	}
+
+	select {
+	case got := <-inner.fired:
+		if got != entry {
+			t.Error("expected the wrapped hook to receive the same entry")
+		}
+	default:
+		t.Error("expected the wrapped hook to have fired")
+	}
+}
+
+func TestAsyncHookDeliversAndCloses(t *testing.T) {
+	inner := newRecordingHook()
+	async := NewAsyncHook(inner, 4)
+
+	entry := &logrus.Entry{Logger: logrus.New(), Message: "hello"}
+	if err := async.Fire(entry); err != nil {
+		t.Fatalf("Fire returned an unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := async.Close(ctx); err != nil {
+		t.Fatalf("Close returned an unexpected error: %v", err)
+	}
+
+	select {
+	case got := <-inner.fired:
+		if got.Message != "hello" {
+			t.Errorf("expected delivered message %q, got %q", "hello", got.Message)
+		}
+	default:
+		t.Error("expected the buffered entry to be flushed to the inner hook on Close")
+	}
+}
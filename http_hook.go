@@ -0,0 +1,105 @@
+package goklogger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// HTTPHookOptions configures the behavior of an HTTPHook.
+type HTTPHookOptions struct {
+	// Client delivers entries to the collector. Defaults to
+	// http.DefaultClient if nil.
+	Client *http.Client
+
+	// Headers are added to every outgoing request, e.g. for authentication.
+	Headers map[string]string
+
+	// Timeout bounds each delivery attempt. Defaults to 5 seconds if zero.
+	Timeout time.Duration
+}
+
+// HTTPHook is a logrus.Hook that POSTs each log entry as JSON to an HTTP
+// collector endpoint.
+type HTTPHook struct {
+	url     string
+	client  *http.Client
+	headers map[string]string
+	timeout time.Duration
+}
+
+// NewHTTPHook returns an HTTPHook that ships JSON log entries to url.
+func NewHTTPHook(url string, opts HTTPHookOptions) *HTTPHook {
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = 5 * time.Second
+	}
+
+	return &HTTPHook{
+		url:     url,
+		client:  client,
+		headers: opts.Headers,
+		timeout: timeout,
+	}
+}
+
+// Levels returns every logrus level; an HTTPHook fires for all of them
+// unless restricted via AddHookForLevels.
+func (h *HTTPHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire marshals entry as JSON and POSTs it to the configured URL.
+func (h *HTTPHook) Fire(entry *logrus.Entry) error {
+	payload := struct {
+		Time    time.Time     `json:"time"`
+		Level   string        `json:"level"`
+		Message string        `json:"message"`
+		Data    logrus.Fields `json:"data,omitempty"`
+	}{
+		Time:    entry.Time,
+		Level:   entry.Level.String(),
+		Message: entry.Message,
+		Data:    entry.Data,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("goklogger: marshaling entry for http hook: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("goklogger: building http hook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range h.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("goklogger: delivering entry to http hook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("goklogger: http hook received status %d from %s", resp.StatusCode, h.url)
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@
 package goklogger
 
 import (
+	"context"
 	"fmt"
 	"runtime/debug"
 	"strings"
@@ -39,6 +40,7 @@ type KLogger struct {
 	Message  string                 `json:"message"`   // A reference to the global logger
 	LogLevel logrus.Level           `json:"log_level"` // The desired log level
 	Data     map[string]interface{} `json:"data"`      // Key value pairs to include in the log output
+	Ctx      context.Context        `json:"-"`         // Set via Logfc/WithContext; governs cancellation-aware emission
 }
 
 // InitializeGlobalLogger Optionally allows you to specify the logger level and formatter
@@ -66,7 +68,9 @@ func SetDefaultFields(fields map[string]interface{}) {
 	defaultFields = fields
 }
 
-// Logf creates a new logger with the given format and arguments.
+// Logf creates a new logger with the given format and arguments. If the
+// calling package has a logger registered via RegisterPackage, its logger and
+// log level are used; otherwise Logf falls back to the global logger.
 func Logf(format string, vars ...interface{}) *KLogger {
 
 	// Set up a global logger with default preferences.  This is
@@ -81,6 +85,14 @@ func Logf(format string, vars ...interface{}) *KLogger {
 		Data:    make(map[string]interface{}),
 	}
 
+	if pkg := callerPackage(); pkg != "" {
+		if logger, level, data, ok := snapshotPackageLogger(pkg); ok {
+			newLogger.Logger = logger
+			newLogger.LogLevel = level
+			newLogger.AddData(data)
+		}
+	}
+
 	return newLogger.AddData(defaultFields)
 }
 
@@ -108,18 +120,43 @@ func (l *KLogger) AddData(data map[string]interface{}) *KLogger {
 	return l
 }
 
-// AddError unpacks the trace of an error and adds it to the logger's data.
+// AddError unpacks the stack trace of an error and adds it to the logger's
+// data. By default the stack is stored as a slice of {"file", "func",
+// "line"} frames so it can be queried in JSON log backends; call
+// SetLegacyStackFormat(true) to restore the original debug.Stack() string
+// blob instead.
 func (l *KLogger) AddError(err error) *KLogger {
-	trace := debug.Stack()
+	return l.addError(err, addErrorSkip)
+}
+
+// AddErrorWithSkip behaves like AddError but lets a function that wraps
+// AddError on a caller's behalf skip extra frames, so the captured stack
+// points at the real call site instead of into the wrapper.
+func (l *KLogger) AddErrorWithSkip(err error, skip int) *KLogger {
+	return l.addError(err, addErrorSkip+skip)
+}
+
+// addErrorSkip is the runtime.Callers skip count for the default (unwrapped)
+// call path: it skips runtime.Callers itself plus goklogger's own AddError
+// plumbing (captureStack, addError, AddError), so the stack starts at the
+// caller of AddError.
+const addErrorSkip = 4
 
+func (l *KLogger) addError(err error, skip int) *KLogger {
 	l.Data["error"] = err.Error()
-	l.Data["stack"] = fmt.Sprintf("%+v", trace)
+
+	if isLegacyStackFormat() {
+		l.Data["stack"] = fmt.Sprintf("%+v", debug.Stack())
+	} else {
+		l.Data["stack"] = captureStack(skip)
+	}
 
 	return l
 }
 
 func (l *KLogger) Info() *KLogger {
-	if l.LogLevel <= logrus.InfoLevel {
+	if l.LogLevel <= logrus.InfoLevel && l.shouldEmit(logrus.InfoLevel) {
+		l.injectCaller("Info")
 		l.Logger.WithFields(l.Data).Info(l.Message)
 	}
 
@@ -127,7 +164,8 @@ func (l *KLogger) Info() *KLogger {
 }
 
 func (l *KLogger) Warn() *KLogger {
-	if l.LogLevel <= logrus.WarnLevel {
+	if l.LogLevel <= logrus.WarnLevel && l.shouldEmit(logrus.WarnLevel) {
+		l.injectCaller("Warn")
 		l.Logger.WithFields(l.Data).Warn(l.Message)
 	}
 
@@ -135,7 +173,8 @@ func (l *KLogger) Warn() *KLogger {
 }
 
 func (l *KLogger) Debug() *KLogger {
-	if l.LogLevel <= logrus.DebugLevel {
+	if l.LogLevel <= logrus.DebugLevel && l.shouldEmit(logrus.DebugLevel) {
+		l.injectCaller("Debug")
 		l.Logger.WithFields(l.Data).Debug(l.Message)
 	}
 
@@ -143,7 +182,8 @@ func (l *KLogger) Debug() *KLogger {
 }
 
 func (l *KLogger) Error() *KLogger {
-	if l.LogLevel <= logrus.ErrorLevel {
+	if l.LogLevel <= logrus.ErrorLevel && l.shouldEmit(logrus.ErrorLevel) {
+		l.injectCaller("Error")
 		l.Logger.WithFields(l.Data).Error(l.Message)
 	}
 
@@ -151,7 +191,8 @@ func (l *KLogger) Error() *KLogger {
 }
 
 func (l *KLogger) Fatal() *KLogger {
-	if l.LogLevel <= logrus.FatalLevel {
+	if l.LogLevel <= logrus.FatalLevel && l.shouldEmit(logrus.FatalLevel) {
+		l.injectCaller("Fatal")
 		l.Logger.WithFields(l.Data).Fatal(l.Message)
 	}
 
@@ -159,7 +200,8 @@ func (l *KLogger) Fatal() *KLogger {
 }
 
 func (l *KLogger) Panic() *KLogger {
-	if l.LogLevel <= logrus.PanicLevel {
+	if l.LogLevel <= logrus.PanicLevel && l.shouldEmit(logrus.PanicLevel) {
+		l.injectCaller("Panic")
 		l.Logger.WithFields(l.Data).Panic(l.Message)
 	}
 
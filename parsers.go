@@ -1,6 +1,7 @@
 package goklogger
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -64,6 +65,41 @@ func StringToLogrusLevel(level string) logrus.Level {
 	}
 }
 
+// StringToLogrusLevelStrict behaves like StringToLogrusLevel but, mirroring
+// logrus's own ParseLevel, returns an error instead of silently defaulting to
+// logrus.InfoLevel when the input does not match a recognized level. This
+// makes it suitable for config loaders that want to surface typos to users
+// rather than mask them.
+func StringToLogrusLevelStrict(level string) (logrus.Level, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(level))
+
+	switch trimmed {
+	case "trace":
+		return logrus.TraceLevel, nil
+
+	case "debug":
+		return logrus.DebugLevel, nil
+
+	case "info":
+		return logrus.InfoLevel, nil
+
+	case "warn", "warning":
+		return logrus.WarnLevel, nil
+
+	case "error":
+		return logrus.ErrorLevel, nil
+
+	case "fatal":
+		return logrus.FatalLevel, nil
+
+	case "panic":
+		return logrus.PanicLevel, nil
+
+	default:
+		return logrus.InfoLevel, fmt.Errorf("not a valid log level: %q", level)
+	}
+}
+
 // LogLevel represents logging levels by their severity.
 type LogLevel int
 
@@ -77,6 +113,30 @@ const (
 	PanicLevel
 )
 
+// toLogrusLevel converts a LogLevel to the logrus.Level it corresponds to. The
+// two types do not share the same underlying integer ordering, so the
+// conversion is explicit rather than a cast.
+func (l LogLevel) toLogrusLevel() logrus.Level {
+	switch l {
+	case TraceLevel:
+		return logrus.TraceLevel
+	case DebugLevel:
+		return logrus.DebugLevel
+	case InfoLevel:
+		return logrus.InfoLevel
+	case WarnLevel:
+		return logrus.WarnLevel
+	case ErrorLevel:
+		return logrus.ErrorLevel
+	case FatalLevel:
+		return logrus.FatalLevel
+	case PanicLevel:
+		return logrus.PanicLevel
+	default:
+		return logrus.InfoLevel
+	}
+}
+
 // StringToLogLevel interprets a string as a logging level and converts it to the corresponding
 // LogLevel constant defined in this package. The function is designed to be case-insensitive
 // and ignores any leading or trailing whitespace in the input string. Recognized levels include
@@ -130,3 +190,74 @@ func StringToLogLevel(level string) LogLevel {
 		return InfoLevel // Default to InfoLevel for unrecognized strings
 	}
 }
+
+// ParseLogLevelStrict behaves like StringToLogLevel but, mirroring logrus's
+// own ParseLevel, returns an error instead of silently defaulting to
+// InfoLevel when the input does not match a recognized level. This makes it
+// suitable for config loaders that want to surface typos to users rather
+// than mask them.
+func ParseLogLevelStrict(level string) (LogLevel, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(level))
+
+	switch trimmed {
+	case "trace":
+		return TraceLevel, nil
+	case "debug":
+		return DebugLevel, nil
+	case "info":
+		return InfoLevel, nil
+	case "warn", "warning":
+		return WarnLevel, nil
+	case "error":
+		return ErrorLevel, nil
+	case "fatal":
+		return FatalLevel, nil
+	case "panic":
+		return PanicLevel, nil
+	default:
+		return InfoLevel, fmt.Errorf("not a valid log level: %q", level)
+	}
+}
+
+// String returns the lowercase name of the log level, e.g. "info" or "warn".
+func (l LogLevel) String() string {
+	switch l {
+	case TraceLevel:
+		return "trace"
+	case DebugLevel:
+		return "debug"
+	case InfoLevel:
+		return "info"
+	case WarnLevel:
+		return "warn"
+	case ErrorLevel:
+		return "error"
+	case FatalLevel:
+		return "fatal"
+	case PanicLevel:
+		return "panic"
+	default:
+		return "info"
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler so that LogLevel values can be
+// written out as their string name in JSON and YAML config files.
+func (l LogLevel) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler so that LogLevel values
+// round-trip through JSON and YAML config files. Unlike StringToLogLevel, an
+// unrecognized level is treated as a config error rather than silently
+// defaulting to InfoLevel.
+func (l *LogLevel) UnmarshalText(text []byte) error {
+	level, err := ParseLogLevelStrict(string(text))
+	if err != nil {
+		return err
+	}
+
+	*l = level
+
+	return nil
+}
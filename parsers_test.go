@@ -1,6 +1,7 @@
 package goklogger
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -77,3 +78,49 @@ func TestStringToLogLevel(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLogLevelStrict(t *testing.T) {
+	if level, err := ParseLogLevelStrict("warn"); err != nil || level != WarnLevel {
+		t.Errorf("ParseLogLevelStrict(%q) = %v, %v; want %v, nil", "warn", level, err, WarnLevel)
+	}
+
+	if _, err := ParseLogLevelStrict("bogus"); err == nil {
+		t.Error("expected an error for an invalid log level, got nil")
+	}
+}
+
+func TestStringToLogrusLevelStrict(t *testing.T) {
+	if level, err := StringToLogrusLevelStrict("error"); err != nil || level != logrus.ErrorLevel {
+		t.Errorf("StringToLogrusLevelStrict(%q) = %v, %v; want %v, nil", "error", level, err, logrus.ErrorLevel)
+	}
+
+	if _, err := StringToLogrusLevelStrict("bogus"); err == nil {
+		t.Error("expected an error for an invalid log level, got nil")
+	}
+}
+
+func TestLogLevelTextRoundTrip(t *testing.T) {
+	type config struct {
+		Level LogLevel `json:"level"`
+	}
+
+	want := config{Level: ErrorLevel}
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal returned an unexpected error: %v", err)
+	}
+
+	var got config
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal returned an unexpected error: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("round-tripped config = %+v, want %+v", got, want)
+	}
+
+	if err := json.Unmarshal([]byte(`{"level":"bogus"}`), &got); err == nil {
+		t.Error("expected an error when unmarshaling an invalid log level, got nil")
+	}
+}
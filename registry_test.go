@@ -0,0 +1,80 @@
+package goklogger
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestSetPackageLogLevelUnregistered(t *testing.T) {
+	if err := SetPackageLogLevel("no/such/package", InfoLevel); err == nil {
+		t.Error("expected an error for an unregistered package, got nil")
+	}
+}
+
+func TestRegisterPackageAndSetLevel(t *testing.T) {
+	const pkgName = "github.com/kmesiab/go-klogger/testpkg"
+
+	logger := RegisterPackage(pkgName, WarnLevel, map[string]interface{}{"foo": "bar"})
+	if logger.Data["foo"] != "bar" {
+		t.Errorf("expected registered logger to carry initial fields, got %v", logger.Data)
+	}
+
+	if err := SetPackageLogLevel(pkgName, ErrorLevel); err != nil {
+		t.Fatalf("SetPackageLogLevel returned an unexpected error: %v", err)
+	}
+
+	_, got, _, ok := snapshotPackageLogger(pkgName)
+	if !ok {
+		t.Fatalf("expected %q to still be registered", pkgName)
+	}
+
+	if got != ErrorLevel.toLogrusLevel() {
+		t.Errorf("expected log level %v, got %v", ErrorLevel.toLogrusLevel(), got)
+	}
+}
+
+func TestRegisteredPackagesHaveIndependentLoggers(t *testing.T) {
+	a := RegisterPackage("pkg/independent-a", ErrorLevel, nil)
+	b := RegisterPackage("pkg/independent-b", DebugLevel, nil)
+
+	if err := SetPackageLogLevel("pkg/independent-a", ErrorLevel); err != nil {
+		t.Fatalf("SetPackageLogLevel returned an unexpected error: %v", err)
+	}
+
+	if a.Logger == b.Logger {
+		t.Fatal("expected each registered package to have its own *logrus.Logger instance")
+	}
+
+	if !b.Logger.IsLevelEnabled(logrus.InfoLevel) {
+		t.Error("expected package b's logger to be unaffected by package a's level change")
+	}
+}
+
+func TestConcurrentSetPackageLogLevelAndSnapshot(t *testing.T) {
+	const pkgName = "pkg/concurrent"
+
+	RegisterPackage(pkgName, DebugLevel, nil)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < 100; i++ {
+			_ = SetPackageLogLevel(pkgName, LogLevel(i%int(PanicLevel+1)))
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < 100; i++ {
+			snapshotPackageLogger(pkgName)
+		}
+	}()
+
+	wg.Wait()
+}
@@ -0,0 +1,91 @@
+package goklogger
+
+import (
+	"runtime"
+	"sync"
+)
+
+var (
+	// stackConfigMu guards legacyStackFormat and stackTraceFilter so they can
+	// be toggled at runtime (e.g. from a SIGHUP handler or an admin
+	// endpoint) while other goroutines are concurrently calling AddError.
+	stackConfigMu sync.RWMutex
+
+	// legacyStackFormat controls whether AddError stores the stack as the
+	// original debug.Stack() string blob instead of structured frames,
+	// preserved for callers that depend on the old "stack" field shape.
+	legacyStackFormat bool
+
+	// stackTraceFilter, when set, is consulted for every frame captured by
+	// AddError and AddErrorWithSkip. Frames for which it returns false (e.g.
+	// vendor or runtime frames) are dropped from the structured stack.
+	stackTraceFilter func(frame runtime.Frame) bool
+)
+
+// SetLegacyStackFormat toggles whether AddError stores the stack trace as
+// the original debug.Stack() string blob rather than structured frames.
+func SetLegacyStackFormat(enabled bool) {
+	stackConfigMu.Lock()
+	defer stackConfigMu.Unlock()
+
+	legacyStackFormat = enabled
+}
+
+// SetStackTraceFilter installs a filter consulted for every frame captured
+// by AddError and AddErrorWithSkip, letting callers strip vendor or runtime
+// frames out of the structured stack. Pass nil to capture every frame.
+func SetStackTraceFilter(filter func(frame runtime.Frame) bool) {
+	stackConfigMu.Lock()
+	defer stackConfigMu.Unlock()
+
+	stackTraceFilter = filter
+}
+
+// isLegacyStackFormat reports the current value of legacyStackFormat.
+func isLegacyStackFormat() bool {
+	stackConfigMu.RLock()
+	defer stackConfigMu.RUnlock()
+
+	return legacyStackFormat
+}
+
+// captureStack gathers the call stack starting skip frames above the
+// runtime.Callers call itself (see the runtime.Callers docs for how skip is
+// counted) and returns it as a slice of maps with "file", "func", and
+// "line" keys, so the stack can be queried in JSON log backends rather than
+// grepped out of a single string blob.
+func captureStack(skip int) []map[string]interface{} {
+	const maxDepth = 64
+
+	pcs := make([]uintptr, maxDepth)
+	n := runtime.Callers(skip, pcs)
+
+	if n == 0 {
+		return nil
+	}
+
+	stackConfigMu.RLock()
+	filter := stackTraceFilter
+	stackConfigMu.RUnlock()
+
+	frames := runtime.CallersFrames(pcs[:n])
+	stack := make([]map[string]interface{}, 0, n)
+
+	for {
+		frame, more := frames.Next()
+
+		if filter == nil || filter(frame) {
+			stack = append(stack, map[string]interface{}{
+				"file": frame.File,
+				"func": frame.Function,
+				"line": frame.Line,
+			})
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return stack
+}
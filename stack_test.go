@@ -0,0 +1,58 @@
+package goklogger
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestAddErrorCapturesStructuredFrames(t *testing.T) {
+	l := &KLogger{Data: make(map[string]interface{})}
+	l.AddError(errors.New("boom"))
+
+	stack, ok := l.Data["stack"].([]map[string]interface{})
+	if !ok || len(stack) == 0 {
+		t.Fatalf("expected a non-empty structured stack, got %v", l.Data["stack"])
+	}
+
+	top := stack[0]
+	if _, ok := top["file"].(string); !ok {
+		t.Errorf("expected frame to have a string \"file\" key, got %v", top)
+	}
+
+	funcName, _ := top["func"].(string)
+	if !strings.Contains(funcName, "TestAddErrorCapturesStructuredFrames") {
+		t.Errorf("expected the top frame to be this test, got %q", funcName)
+	}
+}
+
+func TestAddErrorLegacyStackFormat(t *testing.T) {
+	SetLegacyStackFormat(true)
+	defer SetLegacyStackFormat(false)
+
+	l := &KLogger{Data: make(map[string]interface{})}
+	l.AddError(errors.New("boom"))
+
+	stack, ok := l.Data["stack"].(string)
+	if !ok || stack == "" {
+		t.Fatalf("expected a legacy string stack, got %v", l.Data["stack"])
+	}
+}
+
+func TestStackTraceFilter(t *testing.T) {
+	SetStackTraceFilter(func(frame runtime.Frame) bool {
+		return !strings.Contains(frame.Function, "TestStackTraceFilter")
+	})
+	defer SetStackTraceFilter(nil)
+
+	l := &KLogger{Data: make(map[string]interface{})}
+	l.AddError(errors.New("boom"))
+
+	stack := l.Data["stack"].([]map[string]interface{})
+	for _, frame := range stack {
+		if strings.Contains(frame["func"].(string), "TestStackTraceFilter") {
+			t.Errorf("expected filtered frame to be excluded, got %v", frame)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package goklogger
+
+import (
+	"log/syslog"
+
+	"github.com/sirupsen/logrus"
+	lsyslog "github.com/sirupsen/logrus/hooks/syslog"
+)
+
+// NewSyslogHook returns a logrus.Hook that ships log entries to the syslog
+// daemon reachable at addr over network (e.g. "udp", "tcp", or "" to use the
+// local syslog socket). priority sets the syslog facility/severity and tag
+// identifies this process in syslog output.
+func NewSyslogHook(network, addr string, priority syslog.Priority, tag string) (logrus.Hook, error) {
+	return lsyslog.NewSyslogHook(network, addr, priority, tag)
+}